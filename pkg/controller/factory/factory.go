@@ -0,0 +1,148 @@
+package factory
+
+import (
+	"context"
+	"time"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+)
+
+// SyncContext carries the per-invocation context a Factory-driven SyncFunc can use.
+type SyncContext interface {
+	// QueueKey is the workqueue key that triggered this sync.
+	QueueKey() string
+	// Recorder is the events.Recorder sync functions should use to emit events.
+	Recorder() events.Recorder
+}
+
+// SyncFunc is the function a Controller calls for every queued work item.
+type SyncFunc func(ctx context.Context, syncCtx SyncContext) error
+
+// Controller runs a SyncFunc whenever one of its informers observes a change. It owns queueing,
+// cache-sync waiting, panic recovery, and rate-limited requeueing on error.
+type Controller interface {
+	// Run starts workers goroutines processing the queue and blocks until ctx is done.
+	Run(ctx context.Context, workers int)
+	// Enqueue queues a sync, the same way an observed informer event would. Callers that change state
+	// the Controller reconciles outside of its own informers (e.g. registering a new rule at runtime)
+	// use this to make sure that change is picked up without waiting for an unrelated informer event.
+	Enqueue()
+}
+
+// Factory builds a Controller out of a SyncFunc and the informers that should trigger it.
+type Factory struct {
+	sync      SyncFunc
+	informers []cache.SharedIndexInformer
+}
+
+// New starts building a Factory.
+func New() *Factory {
+	return &Factory{}
+}
+
+// WithSync sets the function the resulting Controller calls for every queued work item.
+func (f *Factory) WithSync(sync SyncFunc) *Factory {
+	f.sync = sync
+	return f
+}
+
+// WithInformers adds informers whose add/update/delete events queue a sync.
+func (f *Factory) WithInformers(informers ...cache.SharedIndexInformer) *Factory {
+	f.informers = append(f.informers, informers...)
+	return f
+}
+
+// ToController builds the Controller, registering an event handler on every informer passed to
+// WithInformers. name identifies the controller in logs, in its workqueue, and in events about its own
+// lifecycle; recorder is used to emit those events.
+func (f *Factory) ToController(name string, recorder events.Recorder) Controller {
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name)
+
+	handler := cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { queue.Add(name) },
+		UpdateFunc: func(old, new interface{}) { queue.Add(name) },
+		DeleteFunc: func(obj interface{}) { queue.Add(name) },
+	}
+	hasSynced := make([]cache.InformerSynced, 0, len(f.informers))
+	for _, informer := range f.informers {
+		informer.AddEventHandler(handler)
+		hasSynced = append(hasSynced, informer.HasSynced)
+	}
+
+	return &controller{
+		name:      name,
+		sync:      f.sync,
+		queue:     queue,
+		hasSynced: hasSynced,
+		recorder:  recorder,
+	}
+}
+
+// controller is the Controller built by Factory.ToController.
+type controller struct {
+	name      string
+	sync      SyncFunc
+	queue     workqueue.RateLimitingInterface
+	hasSynced []cache.InformerSynced
+	recorder  events.Recorder
+}
+
+func (c *controller) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	if !cache.WaitForCacheSync(ctx.Done(), c.hasSynced...) {
+		return
+	}
+
+	if workers < 1 {
+		workers = 1
+	}
+	for i := 0; i < workers; i++ {
+		go wait.Until(func() { c.runWorker(ctx) }, time.Second, ctx.Done())
+	}
+
+	<-ctx.Done()
+}
+
+func (c *controller) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
+	}
+}
+
+func (c *controller) processNextWorkItem(ctx context.Context) bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.sync(ctx, &syncContext{queueKey: key.(string), recorder: c.recorder})
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(err)
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncContext is the SyncContext passed to every SyncFunc invocation.
+type syncContext struct {
+	queueKey string
+	recorder events.Recorder
+}
+
+func (s *syncContext) QueueKey() string          { return s.queueKey }
+func (s *syncContext) Recorder() events.Recorder { return s.recorder }
+
+// Enqueue queues a sync under the same key an informer event would use.
+func (c *controller) Enqueue() {
+	c.queue.Add(c.name)
+}
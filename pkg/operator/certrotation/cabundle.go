@@ -2,12 +2,14 @@ package certrotation
 
 import (
 	"crypto/x509"
+	"fmt"
 	"reflect"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
@@ -40,27 +42,110 @@ func (c CABundleRotation) ensureConfigMapCABundle(signingCertKeyPair *crypto.CA)
 		// create an empty one
 		caBundleConfigMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: c.Namespace, Name: c.Name}}
 	}
+
+	// a pending force-rebuild request means we drop everything we've accumulated so far and rebuild
+	// the bundle from just the current signer, instead of the usual append-and-prune.
+	requestedAt, forceRebuild := forceRebuildRequested(caBundleConfigMap)
+	if forceRebuild {
+		caBundleConfigMap.Data = nil
+		// recordForceRebuildStatus does its own live write, which bumps the configmap's
+		// ResourceVersion on the server: carry that forward so the update below doesn't conflict.
+		if inProgress, err := c.recordForceRebuildStatus(requestedAt, forceCABundleRebuildStatusInProgress); err == nil {
+			caBundleConfigMap.ResourceVersion = inProgress.ResourceVersion
+		}
+	}
+
 	if err := manageCABundleConfigMap(caBundleConfigMap, signingCertKeyPair.Config.Certs[0]); err != nil {
+		if forceRebuild {
+			c.recordForceRebuildStatus(requestedAt, forceCABundleRebuildStatusFailed)
+		}
 		return err
 	}
-	if originalCABundleConfigMap == nil || originalCABundleConfigMap.Data == nil || !equality.Semantic.DeepEqual(originalCABundleConfigMap.Data, caBundleConfigMap.Data) {
+
+	if forceRebuild || originalCABundleConfigMap == nil || originalCABundleConfigMap.Data == nil || !equality.Semantic.DeepEqual(originalCABundleConfigMap.Data, caBundleConfigMap.Data) {
 		c.EventRecorder.Eventf("CABundleUpdateRequired", "%q in %q requires a new cert", c.Namespace, c.Name)
 		actualCABundleConfigMap, err := c.Client.ConfigMaps(c.Namespace).Update(caBundleConfigMap)
 		if apierrors.IsNotFound(err) {
 			actualCABundleConfigMap, err = c.Client.ConfigMaps(c.Namespace).Create(caBundleConfigMap)
-			if err != nil {
-				return err
-			}
 		}
 		if err != nil {
+			if forceRebuild {
+				c.recordForceRebuildStatus(requestedAt, forceCABundleRebuildStatusFailed)
+			}
 			return err
 		}
 		caBundleConfigMap = actualCABundleConfigMap
 	}
 
+	if forceRebuild {
+		c.recordForceRebuildStatus(requestedAt, forceCABundleRebuildStatusDone)
+	}
+
 	return nil
 }
 
+const (
+	// forceCABundleRebuildAnnotation, when set on the ca-bundle configmap, causes ensureConfigMapCABundle
+	// to rebuild the bundle from just the current signer and drop everything else immediately, instead
+	// of waiting for the accumulated entries to expire naturally. Its value is an opaque request token
+	// (conventionally a timestamp).
+	forceCABundleRebuildAnnotation = "operator.openshift.io/force-resync"
+	// forceCABundleRebuildStatusAnnotation records the outcome of the most recently handled
+	// forceCABundleRebuildAnnotation value, so a given request only forces one rebuild even though the
+	// resulting update re-triggers the informer.
+	forceCABundleRebuildStatusAnnotation = "operator.openshift.io/force-resync-status"
+)
+
+const (
+	forceCABundleRebuildStatusInProgress = "in-progress"
+	forceCABundleRebuildStatusDone       = "done"
+	forceCABundleRebuildStatusFailed     = "failed"
+)
+
+func forceCABundleRebuildStatusMarker(status, requestedAt string) string {
+	return fmt.Sprintf("%s:%s", status, requestedAt)
+}
+
+// forceRebuildRequested reports whether caBundleConfigMap carries a forceCABundleRebuildAnnotation
+// value that hasn't already been honored, returning that value so the caller can stamp it back as
+// handled.
+func forceRebuildRequested(caBundleConfigMap *corev1.ConfigMap) (requestedAt string, requested bool) {
+	requestedAt, ok := caBundleConfigMap.Annotations[forceCABundleRebuildAnnotation]
+	if !ok || len(requestedAt) == 0 {
+		return "", false
+	}
+	if caBundleConfigMap.Annotations[forceCABundleRebuildStatusAnnotation] == forceCABundleRebuildStatusMarker(forceCABundleRebuildStatusDone, requestedAt) {
+		return requestedAt, false
+	}
+	return requestedAt, true
+}
+
+// recordForceRebuildStatus stamps the outcome of a force-rebuild request onto the ca-bundle configmap
+// and emits an event, so operators (and humans) can tell a forced rebuild apart from a routine
+// rotation, the same way resourcesynccontroller's recordForceResyncStatus does for synced destinations.
+// It returns the updated configmap so a caller about to write its own copy of the same object can carry
+// the bumped ResourceVersion forward instead of conflicting with this write.
+func (c CABundleRotation) recordForceRebuildStatus(requestedAt, status string) (*corev1.ConfigMap, error) {
+	configMap, err := c.Client.ConfigMaps(c.Namespace).Get(c.Name, metav1.GetOptions{})
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("force-rebuild: get configmap %s/%s: %v", c.Namespace, c.Name, err))
+		return nil, err
+	}
+	configMap = configMap.DeepCopy()
+	if configMap.Annotations == nil {
+		configMap.Annotations = map[string]string{}
+	}
+	configMap.Annotations[forceCABundleRebuildStatusAnnotation] = forceCABundleRebuildStatusMarker(status, requestedAt)
+	updated, err := c.Client.ConfigMaps(c.Namespace).Update(configMap)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("force-rebuild: update configmap %s/%s: %v", c.Namespace, c.Name, err))
+		return nil, err
+	}
+
+	c.EventRecorder.Eventf("CABundleForceRebuild", "force-rebuild of %s/%s completed with status %q", c.Namespace, c.Name, status)
+	return updated, nil
+}
+
 // manageCABundleConfigMap adds the new certificate to the list of cabundles, eliminates duplicates, and prunes the list of expired
 // certs to trust as signers
 func manageCABundleConfigMap(caBundleConfigMap *corev1.ConfigMap, currentSigner *x509.Certificate) error {
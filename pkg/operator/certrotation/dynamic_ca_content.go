@@ -0,0 +1,202 @@
+package certrotation
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/cert"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+)
+
+const dynamicCABundleWorkQueueKey = "key"
+
+// caBundleContent bundles the parsed cert pool together with the raw PEM bytes it was parsed from,
+// so CurrentCABundleContent can hand back both without re-parsing on every call.
+type caBundleContent struct {
+	caBundle []byte
+	certPool *x509.CertPool
+}
+
+// DynamicCABundleContent maintains a live, in-memory view of the ca-bundle.crt key of the configmap
+// managed by a CABundleRotation. Consumers such as an HTTPS transport or an authenticator can read
+// the current trust bundle via CurrentCABundleContent and register an AddListener callback to
+// rebuild their TLS configuration whenever the configmap rotates, instead of polling it.
+type DynamicCABundleContent struct {
+	// name identifies this content for logging purposes.
+	name string
+
+	Namespace string
+	Name      string
+
+	Informer corev1informers.ConfigMapInformer
+	Lister   corev1listers.ConfigMapLister
+
+	EventRecorder events.Recorder
+
+	listenersLock sync.Mutex
+	listeners     []func()
+
+	currentCABundleContent atomic.Value
+
+	queue workqueue.RateLimitingInterface
+}
+
+// NewDynamicCABundleContent returns a DynamicCABundleContent that watches namespace/name through
+// informer/lister. name is used only to disambiguate this content's log and queue messages.
+func NewDynamicCABundleContent(name, namespace, configMapName string, informer corev1informers.ConfigMapInformer, lister corev1listers.ConfigMapLister, eventRecorder events.Recorder) *DynamicCABundleContent {
+	c := &DynamicCABundleContent{
+		name:          name,
+		Namespace:     namespace,
+		Name:          configMapName,
+		Informer:      informer,
+		Lister:        lister,
+		EventRecorder: eventRecorder,
+		queue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "DynamicCABundleContent-"+name),
+	}
+	informer.Informer().AddEventHandler(c.eventHandler())
+
+	return c
+}
+
+// CurrentCABundleContent returns the most recently observed ca-bundle PEM bytes together with the
+// cert pool parsed from them. It never touches the API and is safe to call from any goroutine.
+func (c *DynamicCABundleContent) CurrentCABundleContent() ([]byte, *x509.CertPool) {
+	uncastContent := c.currentCABundleContent.Load()
+	if uncastContent == nil {
+		return nil, nil
+	}
+	content := uncastContent.(caBundleContent)
+	return content.caBundle, content.certPool
+}
+
+// AddListener registers a function to be called whenever the ca bundle content changes. Listeners
+// are called synchronously from the sync loop, so they should not block.
+func (c *DynamicCABundleContent) AddListener(listener func()) {
+	c.listenersLock.Lock()
+	defer c.listenersLock.Unlock()
+	c.listeners = append(c.listeners, listener)
+}
+
+func (c *DynamicCABundleContent) notifyListeners() {
+	c.listenersLock.Lock()
+	defer c.listenersLock.Unlock()
+	for _, listener := range c.listeners {
+		listener()
+	}
+}
+
+// RunOnce populates the current ca bundle content a single time, without starting the workqueue
+// driven loop. Useful for establishing an initial bundle synchronously before Run is started.
+func (c *DynamicCABundleContent) RunOnce(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return c.syncConfigMap()
+}
+
+// Run starts the workers that keep the current ca bundle content up to date until ctx is canceled.
+func (c *DynamicCABundleContent) Run(ctx context.Context, workers int) {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	glog.Infof("Starting DynamicCABundleContent %q", c.name)
+	defer glog.Infof("Shutting down DynamicCABundleContent %q", c.name)
+	if !cache.WaitForCacheSync(ctx.Done(), c.Informer.Informer().HasSynced) {
+		return
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runWorker, time.Second, ctx.Done())
+	}
+
+	<-ctx.Done()
+}
+
+func (c *DynamicCABundleContent) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *DynamicCABundleContent) processNextWorkItem() bool {
+	dsKey, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(dsKey)
+
+	err := c.syncConfigMap()
+	if err == nil {
+		c.queue.Forget(dsKey)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("%v failed with: %v", dsKey, err))
+	c.queue.AddRateLimited(dsKey)
+
+	return true
+}
+
+// syncConfigMap reads the source configmap and, if its ca-bundle.crt content has changed, parses it
+// and publishes the result to CurrentCABundleContent before notifying listeners.
+func (c *DynamicCABundleContent) syncConfigMap() error {
+	configMap, err := c.Lister.ConfigMaps(c.Namespace).Get(c.Name)
+	if apierrors.IsNotFound(err) {
+		if existingContent, ok := c.currentCABundleContent.Load().(caBundleContent); ok && len(existingContent.caBundle) == 0 {
+			return nil
+		}
+		c.currentCABundleContent.Store(caBundleContent{})
+		c.notifyListeners()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	caBundle := []byte(configMap.Data["ca-bundle.crt"])
+	if len(caBundle) == 0 {
+		return fmt.Errorf("configmap %q/%q missing ca-bundle.crt", c.Namespace, c.Name)
+	}
+	certs, err := cert.ParseCertsPEM(caBundle)
+	if err != nil {
+		return fmt.Errorf("configmap %q/%q has an invalid ca-bundle.crt: %v", c.Namespace, c.Name, err)
+	}
+	certPool := x509.NewCertPool()
+	for _, curr := range certs {
+		certPool.AddCert(curr)
+	}
+	newContent := caBundleContent{caBundle: caBundle, certPool: certPool}
+
+	if existingContent, ok := c.currentCABundleContent.Load().(caBundleContent); ok && bytes.Equal(existingContent.caBundle, newContent.caBundle) {
+		return nil
+	}
+
+	c.currentCABundleContent.Store(newContent)
+	c.EventRecorder.Eventf("CABundleContentUpdated", "ca bundle for %q/%q changed", c.Namespace, c.Name)
+	c.notifyListeners()
+
+	return nil
+}
+
+// eventHandler queues a resync on any change to the source configmap.
+func (c *DynamicCABundleContent) eventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.queue.Add(dynamicCABundleWorkQueueKey) },
+		UpdateFunc: func(old, new interface{}) { c.queue.Add(dynamicCABundleWorkQueueKey) },
+		DeleteFunc: func(obj interface{}) { c.queue.Add(dynamicCABundleWorkQueueKey) },
+	}
+}
@@ -0,0 +1,168 @@
+package certrotation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+)
+
+const (
+	testCABundleCert1 = `-----BEGIN CERTIFICATE-----
+MIIDCTCCAfGgAwIBAgIUORgwrYw+Wlcfr6JgypgNAFDWg+8wDQYJKoZIhvcNAQEL
+BQAwFDESMBAGA1UEAwwJdGVzdC1jYS0xMB4XDTI2MDcyNzA3MTQzN1oXDTM2MDcy
+NDA3MTQzN1owFDESMBAGA1UEAwwJdGVzdC1jYS0xMIIBIjANBgkqhkiG9w0BAQEF
+AAOCAQ8AMIIBCgKCAQEA4TbFSRPnKVyjnRlbQFMSOqunyicfJIuTgCtri6oIb0YF
+4oIG4girL60HZzIqHv8/vY9YcSTyOQD/BvlIs0pn+NGBP0ysqf3mhbGe/eW/UBTD
+jMqDAEz04rHEbKvTNbqJuirnjYQpydk+DHk0xwzH1JN5S4e4VVuT2Pzop656M1gW
+G7Y1tMFtPacluSqtvBHziCWNwDQw8lQ1nBtukzvv39zZh/uZxNXxNciH1fluGLhu
+PVRDGnFsBNI4xNsDy+NE6UElkJc2oBvWwuydlyCJJqqM7u12lRMjqPGmW9ZRO1Qv
+TClue57HFh3dCxc5K1ikICNlE2I12wd7zUnfjdWRqQIDAQABo1MwUTAdBgNVHQ4E
+FgQUn1ZyiWOCjLgigrQLF7fxtxlUB+kwHwYDVR0jBBgwFoAUn1ZyiWOCjLgigrQL
+F7fxtxlUB+kwDwYDVR0TAQH/BAUwAwEB/zANBgkqhkiG9w0BAQsFAAOCAQEAfrNv
+UQ0y0KgSIlEJo0wGEgOWnxKUBPeOFbWyMJU7V4a2jW7IblF68lnnXOii65qk5F8r
+wLRTjn9oXkJUhQNY6tqRIBhXfum8VBAybhli2XLcwmfapmI9NFQDZLLjd+8G6ZJz
+ynIYgy03gc7Wfmpz9qC4XvxcN09TSCzTxnx16zVS2lfHAbB+BjmWJ4TO8srrdOYV
+AGAdAm5k3pv8gDhXY3dx8sXUb2Zn70OH8s4fhghYNZ+qIRBJ8Xf76mVWcgZJUggq
+W2tQMgRlvU3rbc+rOppM/ilRWAfi7q4rjo/l/NHoS8NN25wAPO6vOkWpXd7nhfwh
+GiyC9IpkLB215S4BaA==
+-----END CERTIFICATE-----
+`
+	testCABundleCert2 = `-----BEGIN CERTIFICATE-----
+MIIDCTCCAfGgAwIBAgIUKG26BaPrJ0CbbNmS+DjJr3RA0t8wDQYJKoZIhvcNAQEL
+BQAwFDESMBAGA1UEAwwJdGVzdC1jYS0yMB4XDTI2MDcyNzA3MTQzOFoXDTM2MDcy
+NDA3MTQzOFowFDESMBAGA1UEAwwJdGVzdC1jYS0yMIIBIjANBgkqhkiG9w0BAQEF
+AAOCAQ8AMIIBCgKCAQEArq0mMd4ZaNUt3f1vYI8AgNKv8kkgNgiAkMMTXjJwaiT8
+o8qThhLM8x8mxjaf4MRuwKoaRuASlvTXAOrCi+Hvwns0ZwvwA6RT8HGi0Ml1XLJr
+W0QMofyPSraSHKv/KeIEPrTz8FnkZmKp8SAlKgB+VDlqOjrcYYvwt90/wkQnKzaR
+Fi0Z10y0GV5OzMP7b4J87O5BH+fNV8ERWog7eJirHDvovznKcXH8bnH41SUGQg4q
+IZlyG81oD3HRPJPKR6gWEBwD19qpnHcoNZT7V3yUP40sAE/A+9q1UfRLwf2wsOp2
+sZ2B52MVqYEU9psHZ9fhtM216lPD8EdGFww+ljY6bQIDAQABo1MwUTAdBgNVHQ4E
+FgQUuqS7SDkgbI7tZWszKdBcFY+htjUwHwYDVR0jBBgwFoAUuqS7SDkgbI7tZWsz
+KdBcFY+htjUwDwYDVR0TAQH/BAUwAwEB/zANBgkqhkiG9w0BAQsFAAOCAQEAbLQL
+yvdjAsg3Mc1/3OJZ/J3kYnNiIrMf0SL223Hopa5QLGl1/q+n8e2qhEnCSIqKQBQf
+JffzH+QBSdAsoSW/Y7cun/2juRPbAfhxB+onC60iwRc9dWie1iLXznZXnnxKSunq
+edgroDqlWBQPkhtOLa4ZYD6qs2r+utpUJL8gCKf3qaCrWU0TozVOGjZ9Ap11wEMQ
+CbVyxaB1QKKxXz0JYDu2kXVjVsv1mx3WBATOWHuc5UPn/VkqOy9GSswjw6pa0Ntg
+VAQRxyGoDnX6E4igxFR92OxCtyK3dHGK6xai0yaxs9CnWRiMqEdNEop+uD//nNb8
+9AQCDoHal7kBbxjx9w==
+-----END CERTIFICATE-----
+`
+)
+
+func newTestDynamicCABundleContent(t *testing.T, objects ...*corev1.ConfigMap) (*DynamicCABundleContent, chan struct{}) {
+	t.Helper()
+
+	client := fake.NewSimpleClientset()
+	for _, obj := range objects {
+		if _, err := client.CoreV1().ConfigMaps(obj.Namespace).Create(obj); err != nil {
+			t.Fatalf("failed to seed configmap: %v", err)
+		}
+	}
+
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	configMapInformer := informerFactory.Core().V1().ConfigMaps()
+
+	c := NewDynamicCABundleContent("test", "ca-namespace", "ca-bundle", configMapInformer, configMapInformer.Lister(), events.NewInMemoryRecorder("test"))
+
+	stopCh := make(chan struct{})
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	return c, stopCh
+}
+
+func TestDynamicCABundleContentAdd(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ca-namespace", Name: "ca-bundle"},
+		Data:       map[string]string{"ca-bundle.crt": testCABundleCert1},
+	}
+	c, stopCh := newTestDynamicCABundleContent(t, configMap)
+	defer close(stopCh)
+
+	if err := c.RunOnce(context.TODO()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	caBundle, certPool := c.CurrentCABundleContent()
+	if len(caBundle) == 0 {
+		t.Fatal("expected non-empty ca bundle")
+	}
+	if certPool == nil || len(certPool.Subjects()) != 1 {
+		t.Fatalf("expected one cert in the pool, got %v", certPool)
+	}
+}
+
+func TestDynamicCABundleContentUpdate(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ca-namespace", Name: "ca-bundle"},
+		Data:       map[string]string{"ca-bundle.crt": testCABundleCert1},
+	}
+	c, stopCh := newTestDynamicCABundleContent(t, configMap)
+	defer close(stopCh)
+
+	if err := c.RunOnce(context.TODO()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	notified := make(chan struct{}, 1)
+	c.AddListener(func() { notified <- struct{}{} })
+
+	updated := configMap.DeepCopy()
+	updated.Data["ca-bundle.crt"] = testCABundleCert1 + testCABundleCert2
+	if _, err := c.Informer.Lister().ConfigMaps(updated.Namespace).Get(updated.Name); err != nil {
+		t.Fatalf("expected seeded configmap in lister: %v", err)
+	}
+
+	indexer := c.Informer.Informer().GetIndexer()
+	if err := indexer.Update(updated); err != nil {
+		t.Fatalf("failed to update indexer: %v", err)
+	}
+
+	if err := c.syncConfigMap(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case <-notified:
+	case <-time.After(time.Second):
+		t.Fatal("expected listener to be notified of the update")
+	}
+
+	_, certPool := c.CurrentCABundleContent()
+	if certPool == nil || len(certPool.Subjects()) != 2 {
+		t.Fatalf("expected two certs in the pool after update, got %v", certPool)
+	}
+}
+
+func TestDynamicCABundleContentDelete(t *testing.T) {
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ca-namespace", Name: "ca-bundle"},
+		Data:       map[string]string{"ca-bundle.crt": testCABundleCert1},
+	}
+	c, stopCh := newTestDynamicCABundleContent(t, configMap)
+	defer close(stopCh)
+
+	if err := c.RunOnce(context.TODO()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := c.Informer.Informer().GetIndexer().Delete(configMap); err != nil {
+		t.Fatalf("failed to delete from indexer: %v", err)
+	}
+
+	if err := c.syncConfigMap(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	caBundle, certPool := c.CurrentCABundleContent()
+	if len(caBundle) != 0 || certPool != nil {
+		t.Fatalf("expected empty ca bundle content after delete, got %q %v", caBundle, certPool)
+	}
+}
@@ -0,0 +1,91 @@
+package resourceapply
+
+import (
+	"context"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+)
+
+// SyncConfigMap checks if the source configmap exists. If it does, it copies the source's data,
+// stamped with ownerRefs and labels, into targetNamespace/targetName, creating or updating the
+// destination as needed. If the source does not exist, the destination is deleted instead. If force
+// is true, the destination is written even if it already matches the source byte-for-byte, which lets
+// a caller use the write itself (and its resourceVersion bump) as a signal that a forced resync ran.
+// It returns the resulting destination (nil on deletion) and whether the destination was changed.
+func SyncConfigMap(ctx context.Context, client coreclientv1.ConfigMapsGetter, recorder events.Recorder, sourceNamespace, sourceName, targetNamespace, targetName string, ownerRefs []metav1.OwnerReference, labels map[string]string, force bool) (*corev1.ConfigMap, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	source, err := client.ConfigMaps(sourceNamespace).Get(sourceName, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		delErr := client.ConfigMaps(targetNamespace).Delete(targetName, nil)
+		if delErr != nil && !apierrors.IsNotFound(delErr) {
+			return nil, false, delErr
+		}
+		return nil, delErr == nil, nil
+	case err != nil:
+		return nil, false, err
+	}
+
+	required := source.DeepCopy()
+	required.Namespace = targetNamespace
+	required.Name = targetName
+	required.ResourceVersion = ""
+	required.OwnerReferences = ownerRefs
+	required.Labels = labels
+
+	return applyConfigMap(client, recorder, required, force)
+}
+
+// applyConfigMap creates required if it doesn't exist yet, or updates the existing object's data,
+// owner references and labels to match required if they've drifted, or if force is true regardless of
+// drift. It reports whether a write was made.
+func applyConfigMap(client coreclientv1.ConfigMapsGetter, recorder events.Recorder, required *corev1.ConfigMap, force bool) (*corev1.ConfigMap, bool, error) {
+	existing, err := client.ConfigMaps(required.Namespace).Get(required.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		actual, err := client.ConfigMaps(required.Namespace).Create(required)
+		if err != nil {
+			recorder.Warningf("ConfigMapCreateFailed", "Failed to create ConfigMap %s/%s: %v", required.Namespace, required.Name, err)
+			return nil, false, err
+		}
+		recorder.Eventf("ConfigMapCreated", "Created ConfigMap %s/%s", required.Namespace, required.Name)
+		return actual, true, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !force && configMapsEqual(existing, required) {
+		return existing, false, nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.Data = required.Data
+	toUpdate.BinaryData = required.BinaryData
+	toUpdate.OwnerReferences = required.OwnerReferences
+	toUpdate.Labels = required.Labels
+
+	actual, err := client.ConfigMaps(toUpdate.Namespace).Update(toUpdate)
+	if err != nil {
+		recorder.Warningf("ConfigMapUpdateFailed", "Failed to update ConfigMap %s/%s: %v", toUpdate.Namespace, toUpdate.Name, err)
+		return nil, false, err
+	}
+	recorder.Eventf("ConfigMapUpdated", "Updated ConfigMap %s/%s", toUpdate.Namespace, toUpdate.Name)
+	return actual, true, nil
+}
+
+// configMapsEqual reports whether existing already matches what required would stamp onto it.
+func configMapsEqual(existing, required *corev1.ConfigMap) bool {
+	return reflect.DeepEqual(existing.Data, required.Data) &&
+		reflect.DeepEqual(existing.BinaryData, required.BinaryData) &&
+		reflect.DeepEqual(existing.OwnerReferences, required.OwnerReferences) &&
+		reflect.DeepEqual(existing.Labels, required.Labels)
+}
@@ -0,0 +1,100 @@
+package resourcesynccontroller
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+const (
+	// ForceResyncAnnotation, when set on a sync destination, causes the next sync pass to overwrite
+	// that destination from its source even if the content is byte-identical. Its value is treated
+	// as an opaque token (conventionally a timestamp) identifying the request.
+	ForceResyncAnnotation = "operator.openshift.io/force-resync"
+	// forceResyncStatusAnnotation records the outcome of the most recently handled ForceResyncAnnotation
+	// value, so that a given request is only forced once even though the resulting update re-triggers
+	// the informer and queues another sync.
+	forceResyncStatusAnnotation = "operator.openshift.io/force-resync-status"
+)
+
+const (
+	forceResyncStatusInProgress = "in-progress"
+	forceResyncStatusDone       = "done"
+	forceResyncStatusFailed     = "failed"
+)
+
+var (
+	forcedSyncTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "resource_sync_controller_forced_sync_total",
+		Help: "Number of syncs driven by the force-resync annotation, partitioned by destination namespace.",
+	}, []string{"namespace"})
+	driftSyncTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "resource_sync_controller_drift_sync_total",
+		Help: "Number of syncs that wrote a destination because its content had drifted from the source, partitioned by destination namespace.",
+	}, []string{"namespace"})
+)
+
+func init() {
+	prometheus.MustRegister(forcedSyncTotal, driftSyncTotal)
+}
+
+func forceResyncStatusMarker(status, requestedAt string) string {
+	return fmt.Sprintf("%s:%s", status, requestedAt)
+}
+
+// forceResyncRequested reports whether obj carries a ForceResyncAnnotation value that hasn't already
+// been satisfied, returning that value so the caller can stamp it back as handled.
+func forceResyncRequested(obj metav1.Object) (requestedAt string, requested bool) {
+	if obj == nil {
+		return "", false
+	}
+	requestedAt, ok := obj.GetAnnotations()[ForceResyncAnnotation]
+	if !ok || len(requestedAt) == 0 {
+		return "", false
+	}
+	if obj.GetAnnotations()[forceResyncStatusAnnotation] == forceResyncStatusMarker(forceResyncStatusDone, requestedAt) {
+		return requestedAt, false
+	}
+	return requestedAt, true
+}
+
+// recordForceResyncStatus stamps the outcome of a forced resync onto the destination and emits an
+// event, so operators (and humans) can tell a force-resync apart from ordinary drift-driven syncs.
+func (c *ResourceSyncController) recordForceResyncStatus(isSecret bool, namespace, name, requestedAt, status string) {
+	marker := forceResyncStatusMarker(status, requestedAt)
+
+	if isSecret {
+		secret, err := c.kubeClient.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("force-resync: get secret %s/%s: %v", namespace, name, err))
+			return
+		}
+		secret = secret.DeepCopy()
+		if secret.Annotations == nil {
+			secret.Annotations = map[string]string{}
+		}
+		secret.Annotations[forceResyncStatusAnnotation] = marker
+		if _, err := c.kubeClient.CoreV1().Secrets(namespace).Update(secret); err != nil {
+			utilruntime.HandleError(fmt.Errorf("force-resync: update secret %s/%s: %v", namespace, name, err))
+		}
+	} else {
+		configMap, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			utilruntime.HandleError(fmt.Errorf("force-resync: get configmap %s/%s: %v", namespace, name, err))
+			return
+		}
+		configMap = configMap.DeepCopy()
+		if configMap.Annotations == nil {
+			configMap.Annotations = map[string]string{}
+		}
+		configMap.Annotations[forceResyncStatusAnnotation] = marker
+		if _, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Update(configMap); err != nil {
+			utilruntime.HandleError(fmt.Errorf("force-resync: update configmap %s/%s: %v", namespace, name, err))
+		}
+	}
+
+	c.eventRecorder.Eventf("ForceResync", "force-resync of %s/%s completed with status %q", namespace, name, status)
+}
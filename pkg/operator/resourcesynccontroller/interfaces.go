@@ -0,0 +1,28 @@
+package resourcesynccontroller
+
+import "k8s.io/apimachinery/pkg/labels"
+
+// ResourceLocation identifies a configmap or secret to be copied
+type ResourceLocation struct {
+	Namespace string
+	Name      string
+}
+
+var emptyResourceLocation = ResourceLocation{}
+
+// ResourceSyncer is the interface used by operators to configure where resources should be synced.
+type ResourceSyncer interface {
+	// SyncConfigMap indicates that a configmap should be copied (or removed) from a source location to a destination location.
+	// If the source is empty, the destination is removed, unless options says otherwise.
+	SyncConfigMap(destination, source ResourceLocation, options ...SyncOption) error
+	// SyncSecret indicates that a secret should be copied (or removed) from a source location to a destination location.
+	// If the source is empty, the destination is removed, unless options says otherwise.
+	SyncSecret(destination, source ResourceLocation, options ...SyncOption) error
+
+	// BroadcastConfigMap indicates that a configmap should be copied from source into every namespace
+	// whose labels match namespaceSelector, using destName as the name of the copy. The set of destinations
+	// is continuously reconciled against the live set of namespaces: the configmap is created/updated in
+	// newly matching namespaces and removed from namespaces that stop matching. Terminating namespaces
+	// are left alone: nothing is created in or removed from them.
+	BroadcastConfigMap(source ResourceLocation, destName string, namespaceSelector labels.Selector) error
+}
@@ -1,23 +1,29 @@
 package resourcesynccontroller
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/golang/glog"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/library-go/pkg/controller/factory"
 	"github.com/openshift/library-go/pkg/operator/events"
 	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
 	"github.com/openshift/library-go/pkg/operator/staticpod/controller/common"
@@ -26,26 +32,74 @@ import (
 const (
 	operatorStatusResourceSyncControllerFailing = "ResourceSyncControllerFailing"
 	controllerWorkQueueKey                      = "key"
+
+	// allNamespacesKey is the key under which a caller can register, in the
+	// kubeInformersForNamespaces map passed to NewResourceSyncController, an informer factory
+	// that watches all namespaces. It is required for BroadcastConfigMap rules and is otherwise optional.
+	allNamespacesKey = ""
+
+	// broadcastConfigMapMarkerLabel is stamped, with the rule's destName as its value, onto every
+	// copy a BroadcastConfigMap rule creates. syncBroadcastConfigMap only ever deletes a destination
+	// that carries this marker, so it never clobbers a pre-existing configmap that merely happens to
+	// share destName.
+	broadcastConfigMapMarkerLabel = "resourcesynccontroller.operator.openshift.io/broadcast-configmap"
 )
 
+// configMapBroadcastRule describes a single BroadcastConfigMap registration: copy source into
+// destName in every namespace matching namespaceSelector.
+type configMapBroadcastRule struct {
+	source            ResourceLocation
+	namespaceSelector labels.Selector
+}
+
+// syncRule bundles a sync rule's source together with the options that govern how its destination
+// is treated on rule removal and operator removal, and what it gets stamped with on every sync.
+type syncRule struct {
+	source  ResourceLocation
+	options SyncOptions
+}
+
 // ResourceSyncController is a controller that will copy source configmaps and secrets to their destinations.
 // It will also mirror deletions by deleting destinations.
 type ResourceSyncController struct {
 	// syncRuleLock is used to ensure we avoid races on changes to syncing rules
 	syncRuleLock sync.RWMutex
-	// configMapSyncRules is a map from destination location to source location
-	configMapSyncRules map[ResourceLocation]ResourceLocation
-	// secretSyncRules is a map from destination location to source location
-	secretSyncRules map[ResourceLocation]ResourceLocation
+	// configMapSyncRules is a map from destination location to its sync rule
+	configMapSyncRules map[ResourceLocation]syncRule
+	// secretSyncRules is a map from destination location to its sync rule
+	secretSyncRules map[ResourceLocation]syncRule
+	// configMapBroadcastRules is a map from destination name to the broadcast rule that fans the
+	// source out to every namespace matching the rule's namespaceSelector.
+	configMapBroadcastRules map[string]configMapBroadcastRule
 
 	// knownNamespaces is the list of namespaces we are watching.
 	knownNamespaces sets.String
 
+	// namespaceLister is non-nil when the caller registered an informer factory under
+	// allNamespacesKey, which is required to support BroadcastConfigMap rules.
+	namespaceLister corev1listers.NamespaceLister
+
+	// configMapListers and secretListers are keyed the same way as kubeInformersForNamespaces, and
+	// let sync() read a rule's source and destination from cache instead of hitting the live API on
+	// every reconciliation. A namespace's lister only has that namespace's objects in its index, so
+	// configMapLister/secretLister pick the right one for a given namespace.
+	configMapListers map[string]corev1listers.ConfigMapLister
+	secretListers    map[string]corev1listers.SecretLister
+
+	// resourceSyncObservers are notified of every destination's sync outcome.
+	resourceSyncObservers []ResourceSyncObserver
+
 	preRunCachesSynced []cache.InformerSynced
 
 	// queue only ever has one item, but it has nice error handling backoff/retry semantics
 	queue workqueue.RateLimitingInterface
 
+	// enqueue is called by SyncConfigMap/SyncSecret/BroadcastConfigMap to make sure a newly
+	// registered rule is picked up promptly. It defaults to queuing onto queue directly, but
+	// NewResourceSyncControllerWithFactory rebinds it to the factory.Controller's own queue, since
+	// that queue (and not c.queue) is what actually drives reconciliation in that mode.
+	enqueue func()
+
 	kubeClient           kubernetes.Interface
 	operatorConfigClient common.OperatorClient
 	eventRecorder        events.Recorder
@@ -64,19 +118,36 @@ func NewResourceSyncController(
 		operatorConfigClient: operatorConfigClient,
 		eventRecorder:        eventRecorder,
 
-		configMapSyncRules: map[ResourceLocation]ResourceLocation{},
-		secretSyncRules:    map[ResourceLocation]ResourceLocation{},
-		knownNamespaces:    sets.StringKeySet(kubeInformersForNamespaces),
+		configMapSyncRules:      map[ResourceLocation]syncRule{},
+		secretSyncRules:         map[ResourceLocation]syncRule{},
+		configMapBroadcastRules: map[string]configMapBroadcastRule{},
+		knownNamespaces:         sets.StringKeySet(kubeInformersForNamespaces),
+
+		configMapListers: map[string]corev1listers.ConfigMapLister{},
+		secretListers:    map[string]corev1listers.SecretLister{},
 
 		queue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "ResourceSyncController"),
 		kubeClient: kubeClient,
 	}
+	c.enqueue = func() { c.queue.Add(controllerWorkQueueKey) }
+	// the all-namespaces entry, when present, isn't a namespace we sync individual rules into.
+	c.knownNamespaces.Delete(allNamespacesKey)
 
-	for _, informers := range kubeInformersForNamespaces {
+	for namespace, informers := range kubeInformersForNamespaces {
 		informers.Core().V1().ConfigMaps().Informer().AddEventHandler(c.eventHandler())
 		informers.Core().V1().Secrets().Informer().AddEventHandler(c.eventHandler())
 		c.preRunCachesSynced = append(c.preRunCachesSynced, informers.Core().V1().ConfigMaps().Informer().HasSynced)
 		c.preRunCachesSynced = append(c.preRunCachesSynced, informers.Core().V1().Secrets().Informer().HasSynced)
+		c.configMapListers[namespace] = informers.Core().V1().ConfigMaps().Lister()
+		c.secretListers[namespace] = informers.Core().V1().Secrets().Lister()
+
+		if namespace != allNamespacesKey {
+			continue
+		}
+		namespaceInformer := informers.Core().V1().Namespaces()
+		namespaceInformer.Informer().AddEventHandler(c.eventHandler())
+		c.preRunCachesSynced = append(c.preRunCachesSynced, namespaceInformer.Informer().HasSynced)
+		c.namespaceLister = namespaceInformer.Lister()
 	}
 
 	// we watch this just in case someone messes with our status
@@ -85,7 +156,7 @@ func NewResourceSyncController(
 	return c
 }
 
-func (c *ResourceSyncController) SyncConfigMap(destination, source ResourceLocation) error {
+func (c *ResourceSyncController) SyncConfigMap(destination, source ResourceLocation, options ...SyncOption) error {
 	if !c.knownNamespaces.Has(destination.Namespace) {
 		return fmt.Errorf("not watching namespace %q", destination.Namespace)
 	}
@@ -95,14 +166,14 @@ func (c *ResourceSyncController) SyncConfigMap(destination, source ResourceLocat
 
 	c.syncRuleLock.Lock()
 	defer c.syncRuleLock.Unlock()
-	c.configMapSyncRules[destination] = source
+	c.configMapSyncRules[destination] = syncRule{source: source, options: newSyncOptions(options...)}
 
 	// make sure the new rule is picked up
-	c.queue.Add(controllerWorkQueueKey)
+	c.enqueue()
 	return nil
 }
 
-func (c *ResourceSyncController) SyncSecret(destination, source ResourceLocation) error {
+func (c *ResourceSyncController) SyncSecret(destination, source ResourceLocation, options ...SyncOption) error {
 	if !c.knownNamespaces.Has(destination.Namespace) {
 		return fmt.Errorf("not watching namespace %q", destination.Namespace)
 	}
@@ -112,57 +183,199 @@ func (c *ResourceSyncController) SyncSecret(destination, source ResourceLocation
 
 	c.syncRuleLock.Lock()
 	defer c.syncRuleLock.Unlock()
-	c.secretSyncRules[destination] = source
+	c.secretSyncRules[destination] = syncRule{source: source, options: newSyncOptions(options...)}
 
 	// make sure the new rule is picked up
-	c.queue.Add(controllerWorkQueueKey)
+	c.enqueue()
 	return nil
 }
 
-func (c *ResourceSyncController) sync() error {
-	operatorSpec, _, _, err := c.operatorConfigClient.Get()
-	if err != nil {
+func (c *ResourceSyncController) BroadcastConfigMap(source ResourceLocation, destName string, namespaceSelector labels.Selector) error {
+	if c.namespaceLister == nil {
+		return fmt.Errorf("not watching all namespaces: register an informer factory under the %q key to use BroadcastConfigMap", allNamespacesKey)
+	}
+	if source != emptyResourceLocation && !c.knownNamespaces.Has(source.Namespace) {
+		return fmt.Errorf("not watching namespace %q", source.Namespace)
+	}
+
+	c.syncRuleLock.Lock()
+	defer c.syncRuleLock.Unlock()
+	c.configMapBroadcastRules[destName] = configMapBroadcastRule{source: source, namespaceSelector: namespaceSelector}
+
+	// make sure the new rule is picked up
+	c.enqueue()
+	return nil
+}
+
+// configMapLister returns the ConfigMapLister that indexes namespace, falling back to the
+// allNamespacesKey lister (present whenever a caller registered an informer factory watching all
+// namespaces) so that namespaces only reachable through it are still served from cache.
+func (c *ResourceSyncController) configMapLister(namespace string) corev1listers.ConfigMapLister {
+	if lister, ok := c.configMapListers[namespace]; ok {
+		return lister
+	}
+	return c.configMapListers[allNamespacesKey]
+}
+
+// secretLister returns the SecretLister that indexes namespace, falling back to the allNamespacesKey
+// lister the same way configMapLister does.
+func (c *ResourceSyncController) secretLister(namespace string) corev1listers.SecretLister {
+	if lister, ok := c.secretListers[namespace]; ok {
+		return lister
+	}
+	return c.secretListers[allNamespacesKey]
+}
+
+func (c *ResourceSyncController) sync(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
 
-	switch operatorSpec.ManagementState {
-	case operatorv1.Unmanaged:
-		return nil
-	case operatorv1.Removed:
-		// TODO: Should we try to actively remove the resources created by this controller here?
-		return nil
+	operatorSpec, _, _, err := c.operatorConfigClient.Get()
+	if err != nil {
+		return err
 	}
 
 	c.syncRuleLock.RLock()
 	defer c.syncRuleLock.RUnlock()
 
 	errors := []error{}
-
-	for destination, source := range c.configMapSyncRules {
-		if source == emptyResourceLocation {
+	syncStatuses := []ResourceSyncStatus{}
+
+	if operatorSpec.ManagementState == operatorv1.Removed {
+		// destinations default to surviving operator removal (e.g. bootstrap kubeconfigs or signer
+		// CAs); only rules that opted in with WithRemoveOnManagementStateRemoved are cleaned up here.
+		for destination, rule := range c.configMapSyncRules {
+			if !rule.options.removeOnManagementStateRemoved {
+				continue
+			}
 			if err := c.kubeClient.CoreV1().ConfigMaps(destination.Namespace).Delete(destination.Name, nil); err != nil && !apierrors.IsNotFound(err) {
 				errors = append(errors, err)
 			}
+		}
+		for destination, rule := range c.secretSyncRules {
+			if !rule.options.removeOnManagementStateRemoved {
+				continue
+			}
+			if err := c.kubeClient.CoreV1().Secrets(destination.Namespace).Delete(destination.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+				errors = append(errors, err)
+			}
+		}
+		return utilerrors.NewAggregate(errors)
+	}
+
+	if operatorSpec.ManagementState == operatorv1.Unmanaged {
+		return nil
+	}
+
+	for destination, rule := range c.configMapSyncRules {
+		if rule.source == emptyResourceLocation {
+			if rule.options.preserveOnDelete {
+				continue
+			}
+			err := c.kubeClient.CoreV1().ConfigMaps(destination.Namespace).Delete(destination.Name, nil)
+			deleted := err == nil
+			if apierrors.IsNotFound(err) {
+				err = nil
+			}
+			if err != nil {
+				errors = append(errors, err)
+			}
+			syncStatuses = append(syncStatuses, c.recordSyncResult(destination, rule.source, SyncResult{Deleted: deleted, Error: err}))
 			continue
 		}
 
-		_, _, err := resourceapply.SyncConfigMap(c.kubeClient.CoreV1(), c.eventRecorder, source.Namespace, source.Name, destination.Namespace, destination.Name, []metav1.OwnerReference{})
+		requestedAt, forceRequested := "", false
+		if existing, err := c.configMapLister(destination.Namespace).ConfigMaps(destination.Namespace).Get(destination.Name); err == nil {
+			requestedAt, forceRequested = forceResyncRequested(existing)
+		}
+		if forceRequested {
+			c.recordForceResyncStatus(false, destination.Namespace, destination.Name, requestedAt, forceResyncStatusInProgress)
+		}
+
+		actual, modified, err := resourceapply.SyncConfigMap(ctx, c.kubeClient.CoreV1(), c.eventRecorder, rule.source.Namespace, rule.source.Name, destination.Namespace, destination.Name, rule.options.ownerRefs, rule.options.labels, forceRequested)
 		if err != nil {
 			errors = append(errors, err)
 		}
+		switch {
+		case forceRequested:
+			status := forceResyncStatusDone
+			if err != nil {
+				status = forceResyncStatusFailed
+			}
+			c.recordForceResyncStatus(false, destination.Namespace, destination.Name, requestedAt, status)
+			forcedSyncTotal.WithLabelValues(destination.Namespace).Inc()
+		case modified:
+			driftSyncTotal.WithLabelValues(destination.Namespace).Inc()
+		}
+
+		// resourceapply reports a deletion (missing source) as modified with a nil destination.
+		result := SyncResult{Synced: modified && actual != nil, Error: err}
+		if actual != nil {
+			result.DestinationResourceVersion = actual.ResourceVersion
+			result.Keys = sortedKeys(actual.Data)
+		}
+		if source, sourceErr := c.configMapLister(rule.source.Namespace).ConfigMaps(rule.source.Namespace).Get(rule.source.Name); sourceErr == nil {
+			result.SourceResourceVersion = source.ResourceVersion
+		}
+		syncStatuses = append(syncStatuses, c.recordSyncResult(destination, rule.source, result))
 	}
-	for destination, source := range c.secretSyncRules {
-		if source == emptyResourceLocation {
-			if err := c.kubeClient.CoreV1().Secrets(destination.Namespace).Delete(destination.Name, nil); err != nil && !apierrors.IsNotFound(err) {
+	for destination, rule := range c.secretSyncRules {
+		if rule.source == emptyResourceLocation {
+			if rule.options.preserveOnDelete {
+				continue
+			}
+			err := c.kubeClient.CoreV1().Secrets(destination.Namespace).Delete(destination.Name, nil)
+			deleted := err == nil
+			if apierrors.IsNotFound(err) {
+				err = nil
+			}
+			if err != nil {
 				errors = append(errors, err)
 			}
+			syncStatuses = append(syncStatuses, c.recordSyncResult(destination, rule.source, SyncResult{Deleted: deleted, Error: err}))
 			continue
 		}
 
-		_, _, err := resourceapply.SyncSecret(c.kubeClient.CoreV1(), c.eventRecorder, source.Namespace, source.Name, destination.Namespace, destination.Name, []metav1.OwnerReference{})
+		requestedAt, forceRequested := "", false
+		if existing, err := c.secretLister(destination.Namespace).Secrets(destination.Namespace).Get(destination.Name); err == nil {
+			requestedAt, forceRequested = forceResyncRequested(existing)
+		}
+		if forceRequested {
+			c.recordForceResyncStatus(true, destination.Namespace, destination.Name, requestedAt, forceResyncStatusInProgress)
+		}
+
+		actual, modified, err := resourceapply.SyncSecret(ctx, c.kubeClient.CoreV1(), c.eventRecorder, rule.source.Namespace, rule.source.Name, destination.Namespace, destination.Name, rule.options.ownerRefs, rule.options.labels, forceRequested)
 		if err != nil {
 			errors = append(errors, err)
 		}
+		switch {
+		case forceRequested:
+			status := forceResyncStatusDone
+			if err != nil {
+				status = forceResyncStatusFailed
+			}
+			c.recordForceResyncStatus(true, destination.Namespace, destination.Name, requestedAt, status)
+			forcedSyncTotal.WithLabelValues(destination.Namespace).Inc()
+		case modified:
+			driftSyncTotal.WithLabelValues(destination.Namespace).Inc()
+		}
+
+		result := SyncResult{Synced: modified && actual != nil, Error: err}
+		if actual != nil {
+			result.DestinationResourceVersion = actual.ResourceVersion
+			result.Keys = sortedKeys(stringifySecretData(actual.Data))
+		}
+		if source, sourceErr := c.secretLister(rule.source.Namespace).Secrets(rule.source.Namespace).Get(rule.source.Name); sourceErr == nil {
+			result.SourceResourceVersion = source.ResourceVersion
+		}
+		syncStatuses = append(syncStatuses, c.recordSyncResult(destination, rule.source, result))
+	}
+
+	for destName, rule := range c.configMapBroadcastRules {
+		if err := c.syncBroadcastConfigMap(ctx, destName, rule); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
 	if len(errors) > 0 {
@@ -172,7 +385,7 @@ func (c *ResourceSyncController) sync() error {
 			Reason:  "Error",
 			Message: common.NewMultiLineAggregate(errors).Error(),
 		}
-		if _, _, updateError := common.UpdateStatus(c.operatorConfigClient, common.UpdateConditionFn(cond)); updateError != nil {
+		if _, _, updateError := common.UpdateStatus(ctx, c.operatorConfigClient, common.UpdateConditionFn(cond), UpdateResourceSyncStatusFn(syncStatuses)); updateError != nil {
 			return updateError
 		}
 		return nil
@@ -182,41 +395,135 @@ func (c *ResourceSyncController) sync() error {
 		Type:   operatorStatusResourceSyncControllerFailing,
 		Status: operatorv1.ConditionFalse,
 	}
-	if _, _, updateError := common.UpdateStatus(c.operatorConfigClient, common.UpdateConditionFn(cond)); updateError != nil {
+	if _, _, updateError := common.UpdateStatus(ctx, c.operatorConfigClient, common.UpdateConditionFn(cond), UpdateResourceSyncStatusFn(syncStatuses)); updateError != nil {
 		return updateError
 	}
 	return nil
 }
 
+// syncBroadcastConfigMap reconciles a single BroadcastConfigMap rule against the live set of
+// namespaces: it copies the source configmap, under destName, into every namespace matching the
+// rule's namespaceSelector, and deletes destName from namespaces that no longer match. Every copy is
+// stamped with broadcastConfigMapMarkerLabel so that the delete branch only ever removes a configmap
+// this rule itself created, never a pre-existing, unrelated configmap that happens to share destName.
+// Namespaces in the Terminating phase are skipped entirely (no create, no delete): a Delete issued
+// against a terminating namespace only generates spurious errors/events while it tears down.
+// Per-namespace errors are aggregated and returned as a single summarizing error so that one failing
+// namespace doesn't prevent the others from being reconciled.
+func (c *ResourceSyncController) syncBroadcastConfigMap(ctx context.Context, destName string, rule configMapBroadcastRule) error {
+	namespaces, err := c.namespaceLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	// a missing source is handled by deleteBroadcastConfigMap like a cleared one: resourceapply.SyncConfigMap
+	// would delete destName without checking broadcastConfigMapMarkerLabel.
+	sourceMissing := rule.source == emptyResourceLocation
+	if !sourceMissing {
+		_, err := c.kubeClient.CoreV1().ConfigMaps(rule.source.Namespace).Get(rule.source.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			sourceMissing = true
+		case err != nil:
+			return err
+		}
+	}
+
+	namespaceErrors := []error{}
+	for _, namespace := range namespaces {
+		if namespace.Status.Phase == corev1.NamespaceTerminating {
+			continue
+		}
+
+		matches := rule.namespaceSelector.Matches(labels.Set(namespace.Labels))
+
+		if !matches || sourceMissing {
+			if err := c.deleteBroadcastConfigMap(namespace.Name, destName); err != nil {
+				namespaceErrors = append(namespaceErrors, fmt.Errorf("namespace %q: %v", namespace.Name, err))
+			}
+			continue
+		}
+
+		labels := map[string]string{broadcastConfigMapMarkerLabel: destName}
+		if _, _, err := resourceapply.SyncConfigMap(ctx, c.kubeClient.CoreV1(), c.eventRecorder, rule.source.Namespace, rule.source.Name, namespace.Name, destName, []metav1.OwnerReference{}, labels, false); err != nil {
+			namespaceErrors = append(namespaceErrors, fmt.Errorf("namespace %q: %v", namespace.Name, err))
+		}
+	}
+
+	if len(namespaceErrors) == 0 {
+		return nil
+	}
+	return fmt.Errorf("broadcasting configmap %q failed in %d of %d namespace(s): %v", destName, len(namespaceErrors), len(namespaces), utilerrors.NewAggregate(namespaceErrors))
+}
+
+// deleteBroadcastConfigMap deletes namespace/destName only if it carries broadcastConfigMapMarkerLabel
+// with a value of destName, i.e. only if a BroadcastConfigMap rule put it there. This keeps a rule
+// whose selector no longer matches a namespace (or whose source was cleared) from deleting a
+// pre-existing, unrelated configmap that simply happens to share destName.
+func (c *ResourceSyncController) deleteBroadcastConfigMap(namespace, destName string) error {
+	existing, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Get(destName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if existing.Labels[broadcastConfigMapMarkerLabel] != destName {
+		return nil
+	}
+
+	err = c.kubeClient.CoreV1().ConfigMaps(namespace).Delete(destName, nil)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// Run starts the controller's own bespoke workqueue loop: it waits for caches to sync, then runs a
+// single worker until stopCh is closed.
+//
+// Deprecated: use NewResourceSyncControllerWithFactory instead, and call Run on the factory.Controller
+// it returns. That path gives panic recovery for free and lets the controller be driven by the same
+// context cancellation as the rest of an operator's controllers. It does not take part in leader
+// election. This
+// method is kept only so existing callers that still pass a stopCh continue to build.
 func (c *ResourceSyncController) Run(workers int, stopCh <-chan struct{}) {
+	ctx, cancel := contextFromStopCh(stopCh)
+	defer cancel()
+	c.run(ctx)
+}
+
+// run is the shared implementation behind the deprecated Run(workers, stopCh) and is abandoned in
+// favor of factorySync once a caller switches to NewResourceSyncControllerWithFactory.
+func (c *ResourceSyncController) run(ctx context.Context) {
 	defer utilruntime.HandleCrash()
 	defer c.queue.ShutDown()
 
 	glog.Infof("Starting ResourceSyncController")
 	defer glog.Infof("Shutting down ResourceSyncController")
-	if !cache.WaitForCacheSync(stopCh, c.preRunCachesSynced...) {
+	if !cache.WaitForCacheSync(ctx.Done(), c.preRunCachesSynced...) {
 		return
 	}
 
 	// doesn't matter what workers say, only start one.
-	go wait.Until(c.runWorker, time.Second, stopCh)
+	go wait.Until(func() { c.runWorker(ctx) }, time.Second, ctx.Done())
 
-	<-stopCh
+	<-ctx.Done()
 }
 
-func (c *ResourceSyncController) runWorker() {
-	for c.processNextWorkItem() {
+func (c *ResourceSyncController) runWorker(ctx context.Context) {
+	for c.processNextWorkItem(ctx) {
 	}
 }
 
-func (c *ResourceSyncController) processNextWorkItem() bool {
+func (c *ResourceSyncController) processNextWorkItem(ctx context.Context) bool {
 	dsKey, quit := c.queue.Get()
 	if quit {
 		return false
 	}
 	defer c.queue.Done(dsKey)
 
-	err := c.sync()
+	err := c.sync(ctx)
 	if err == nil {
 		c.queue.Forget(dsKey)
 		return true
@@ -228,6 +535,59 @@ func (c *ResourceSyncController) processNextWorkItem() bool {
 	return true
 }
 
+// contextFromStopCh adapts a stopCh-style shutdown signal to a context.Context, for callers of the
+// deprecated Run(workers, stopCh) signature. The returned cancel must be called once the caller is
+// done with the context, to release the goroutine watching stopCh.
+func contextFromStopCh(stopCh <-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-stopCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// factorySync adapts sync to the signature a factory.Factory expects of its sync function.
+func (c *ResourceSyncController) factorySync(ctx context.Context, _ factory.SyncContext) error {
+	return c.sync(ctx)
+}
+
+// NewResourceSyncControllerWithFactory creates a ResourceSyncController exactly like
+// NewResourceSyncController, but hands its reconciliation off to a factory.Controller instead of the
+// bespoke Run loop above: the factory owns queueing, cache-sync waiting, panic recovery, and
+// shutdown on context cancellation. It does not take part in leader election. The returned
+// *ResourceSyncController is still the one to call SyncConfigMap/SyncSecret/BroadcastConfigMap/
+// AddResourceSyncObserver on; only the returned factory.Controller's Run should be started.
+func NewResourceSyncControllerWithFactory(
+	operatorConfigClient common.OperatorClient,
+	kubeInformersForNamespaces map[string]informers.SharedInformerFactory,
+	kubeClient kubernetes.Interface,
+	eventRecorder events.Recorder,
+) (*ResourceSyncController, factory.Controller) {
+	c := NewResourceSyncController(operatorConfigClient, kubeInformersForNamespaces, kubeClient, eventRecorder)
+
+	f := factory.New().WithSync(c.factorySync)
+	for namespace, informers := range kubeInformersForNamespaces {
+		f = f.WithInformers(informers.Core().V1().ConfigMaps().Informer(), informers.Core().V1().Secrets().Informer())
+		if namespace == allNamespacesKey {
+			// required so BroadcastConfigMap rules, same as NewResourceSyncController's own wiring.
+			f = f.WithInformers(informers.Core().V1().Namespaces().Informer())
+		}
+	}
+	f = f.WithInformers(operatorConfigClient.Informer())
+
+	factoryController := f.ToController("ResourceSyncController", eventRecorder)
+	// reconciliation in this mode is driven off the factory's own queue, not c.queue: route the
+	// public API's "pick this up now" signal there instead, or newly registered rules would sit
+	// unreconciled until an unrelated informer event happened to fire.
+	c.enqueue = factoryController.Enqueue
+
+	return c, factoryController
+}
+
 // eventHandler queues the operator to check spec and status
 func (c *ResourceSyncController) eventHandler() cache.ResourceEventHandler {
 	return cache.ResourceEventHandlerFuncs{
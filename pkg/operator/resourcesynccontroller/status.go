@@ -0,0 +1,173 @@
+package resourcesynccontroller
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/library-go/pkg/operator/staticpod/controller/common"
+)
+
+// operatorStatusResourceSyncStatus is the condition type under which the per-destination
+// ResourceSyncStatus slice is published, since OperatorStatus has no generic extension field to
+// attach structured, non-boolean status to.
+const operatorStatusResourceSyncStatus = "ResourceSyncStatus"
+
+// SyncResult captures the outcome of a single destination's sync pass. It is handed to every
+// registered ResourceSyncObserver and folded into the destination's ResourceSyncStatus entry.
+type SyncResult struct {
+	// Synced is true when the destination was created or updated during this pass. It is false for
+	// passes that left the destination untouched, and for deletions.
+	Synced bool
+	// Deleted is true when the destination was removed during this pass because its rule's source
+	// became empty.
+	Deleted bool
+	// SourceResourceVersion is the resourceVersion of the source object as observed during this pass.
+	SourceResourceVersion string
+	// DestinationResourceVersion is the resourceVersion of the destination object after this pass.
+	DestinationResourceVersion string
+	// Keys lists the data keys copied into the destination. Empty for deletions.
+	Keys []string
+	// Error is the error, if any, encountered while syncing this destination.
+	Error error
+}
+
+// ResourceSyncStatus is the per-destination outcome tracked in the operator's structured resource
+// sync status.
+type ResourceSyncStatus struct {
+	Destination ResourceLocation `json:"destination"`
+	Source      ResourceLocation `json:"source"`
+
+	LastSyncTime               metav1.Time `json:"lastSyncTime"`
+	SourceResourceVersion      string      `json:"sourceResourceVersion,omitempty"`
+	DestinationResourceVersion string      `json:"destinationResourceVersion,omitempty"`
+	Keys                       []string    `json:"keys,omitempty"`
+	LastError                  string      `json:"lastError,omitempty"`
+}
+
+// ResourceSyncObserver lets callers outside the operator, such as a metrics exporter, subscribe to
+// every sync outcome without having to poll the operator's status.
+type ResourceSyncObserver interface {
+	OnSync(destination, source ResourceLocation, result SyncResult)
+}
+
+var (
+	syncAttemptsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "resource_sync_controller_sync_attempts_total",
+		Help: "Number of attempts to sync a destination configmap or secret, partitioned by destination namespace.",
+	}, []string{"namespace"})
+	syncFailuresTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "resource_sync_controller_sync_failures_total",
+		Help: "Number of failed attempts to sync a destination configmap or secret, partitioned by destination namespace.",
+	}, []string{"namespace"})
+	syncDeletionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "resource_sync_controller_sync_deletions_total",
+		Help: "Number of destination configmaps or secrets deleted because their rule's source was emptied, partitioned by destination namespace.",
+	}, []string{"namespace"})
+)
+
+func init() {
+	prometheus.MustRegister(syncAttemptsTotal, syncFailuresTotal, syncDeletionsTotal)
+}
+
+// AddResourceSyncObserver registers observer to be called with the outcome of every destination sync.
+func (c *ResourceSyncController) AddResourceSyncObserver(observer ResourceSyncObserver) {
+	c.syncRuleLock.Lock()
+	defer c.syncRuleLock.Unlock()
+	c.resourceSyncObservers = append(c.resourceSyncObservers, observer)
+}
+
+// recordSyncResult updates the per-destination metrics, notifies observers, and returns the
+// ResourceSyncStatus entry for destination so the caller can fold it into the aggregate status.
+func (c *ResourceSyncController) recordSyncResult(destination, source ResourceLocation, result SyncResult) ResourceSyncStatus {
+	syncAttemptsTotal.WithLabelValues(destination.Namespace).Inc()
+	if result.Error != nil {
+		syncFailuresTotal.WithLabelValues(destination.Namespace).Inc()
+	}
+	if result.Deleted {
+		syncDeletionsTotal.WithLabelValues(destination.Namespace).Inc()
+	}
+
+	for _, observer := range c.resourceSyncObservers {
+		observer.OnSync(destination, source, result)
+	}
+
+	status := ResourceSyncStatus{
+		Destination:                destination,
+		Source:                     source,
+		LastSyncTime:               metav1.Now(),
+		SourceResourceVersion:      result.SourceResourceVersion,
+		DestinationResourceVersion: result.DestinationResourceVersion,
+		Keys:                       result.Keys,
+	}
+	if result.Error != nil {
+		status.LastError = result.Error.Error()
+	}
+	return status
+}
+
+// sortedKeys returns the keys of data in sorted order, for deterministic status reporting.
+func sortedKeys(data map[string]string) []string {
+	if len(data) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// stringifySecretData discards a secret's Data values, keeping only its keys, so sortedKeys can be
+// reused to report which keys a secret sync copied without reporting their (sensitive) contents.
+func stringifySecretData(data map[string][]byte) map[string]string {
+	if len(data) == 0 {
+		return nil
+	}
+	keys := make(map[string]string, len(data))
+	for key := range data {
+		keys[key] = ""
+	}
+	return keys
+}
+
+// UpdateResourceSyncStatusFn returns a common.UpdateStatusFunc that publishes statuses as the
+// Message of a dedicated ResourceSyncStatus condition. The condition's Status/Reason reflect whether
+// any destination in statuses carries a LastError, so a failing sync isn't reported as healthy.
+func UpdateResourceSyncStatusFn(statuses []ResourceSyncStatus) common.UpdateStatusFunc {
+	return func(status *operatorv1.OperatorStatus) error {
+		encoded, err := json.Marshal(statuses)
+		if err != nil {
+			return fmt.Errorf("failed to encode resource sync status: %v", err)
+		}
+
+		newCondition := operatorv1.OperatorCondition{
+			Type:    operatorStatusResourceSyncStatus,
+			Status:  operatorv1.ConditionTrue,
+			Reason:  "AsExpected",
+			Message: string(encoded),
+		}
+		for _, s := range statuses {
+			if len(s.LastError) == 0 {
+				continue
+			}
+			newCondition.Status = operatorv1.ConditionFalse
+			newCondition.Reason = "Error"
+			break
+		}
+		for i := range status.Conditions {
+			if status.Conditions[i].Type == newCondition.Type {
+				status.Conditions[i] = newCondition
+				return nil
+			}
+		}
+		status.Conditions = append(status.Conditions, newCondition)
+		return nil
+	}
+}
@@ -0,0 +1,59 @@
+package resourcesynccontroller
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// SyncOptions controls how a single sync rule's destination is treated when the rule is dropped
+// (source set to empty) or when the operator transitions to ManagementState: Removed. They also let
+// callers stamp the destination with owner references and labels.
+type SyncOptions struct {
+	// preserveOnDelete, when true, means the destination is left in place instead of being deleted
+	// when the rule's source becomes empty. Useful for bootstrap artifacts, such as kubeconfigs or
+	// signer CAs, that must outlive the rule that produced them.
+	preserveOnDelete bool
+	// removeOnManagementStateRemoved, when true, means the destination is actively deleted when the
+	// operator transitions to ManagementState: Removed. The default is to leave it untouched.
+	removeOnManagementStateRemoved bool
+
+	ownerRefs []metav1.OwnerReference
+	labels    map[string]string
+}
+
+// SyncOption mutates a SyncOptions. Pass one or more to SyncConfigMap/SyncSecret.
+type SyncOption func(*SyncOptions)
+
+// WithPreserveOnDelete marks the destination as one that must survive its sync rule being removed.
+func WithPreserveOnDelete() SyncOption {
+	return func(o *SyncOptions) {
+		o.preserveOnDelete = true
+	}
+}
+
+// WithRemoveOnManagementStateRemoved opts the destination into active cleanup when the operator
+// transitions to ManagementState: Removed, instead of the default of leaving it in place.
+func WithRemoveOnManagementStateRemoved() SyncOption {
+	return func(o *SyncOptions) {
+		o.removeOnManagementStateRemoved = true
+	}
+}
+
+// WithOwnerReferences sets the owner references stamped onto the destination on every sync.
+func WithOwnerReferences(ownerRefs ...metav1.OwnerReference) SyncOption {
+	return func(o *SyncOptions) {
+		o.ownerRefs = ownerRefs
+	}
+}
+
+// WithLabels sets the labels stamped onto the destination on every sync.
+func WithLabels(labels map[string]string) SyncOption {
+	return func(o *SyncOptions) {
+		o.labels = labels
+	}
+}
+
+func newSyncOptions(options ...SyncOption) SyncOptions {
+	o := SyncOptions{}
+	for _, option := range options {
+		option(&o)
+	}
+	return o
+}
@@ -0,0 +1,95 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+// OperatorClient is the narrow interface controllers use to read and update the status of the
+// operator resource they manage, without needing to know its concrete type.
+type OperatorClient interface {
+	// Informer returns the informer backing Get, so controllers can react to changes made by others.
+	Informer() cache.SharedIndexInformer
+	// Get returns the operator resource's current spec and status, along with the resourceVersion
+	// UpdateStatus must be called with to avoid clobbering a concurrent update.
+	Get() (spec *operatorv1.OperatorSpec, status *operatorv1.OperatorStatus, resourceVersion string, err error)
+	// UpdateStatus persists status, failing with a conflict error if resourceVersion is stale.
+	UpdateStatus(status *operatorv1.OperatorStatus, resourceVersion string) (*operatorv1.OperatorStatus, error)
+}
+
+// UpdateStatusFunc mutates status in place. Returning an error aborts the update.
+type UpdateStatusFunc func(status *operatorv1.OperatorStatus) error
+
+// UpdateConditionFn returns an UpdateStatusFunc that upserts cond into status.Conditions.
+func UpdateConditionFn(cond operatorv1.OperatorCondition) UpdateStatusFunc {
+	return func(status *operatorv1.OperatorStatus) error {
+		for i := range status.Conditions {
+			if status.Conditions[i].Type == cond.Type {
+				status.Conditions[i] = cond
+				return nil
+			}
+		}
+		status.Conditions = append(status.Conditions, cond)
+		return nil
+	}
+}
+
+// UpdateStatus applies updateFuncs to client's current status and writes the result back, retrying on
+// update conflicts. It returns the resulting status and whether a write was actually made (a no-op
+// when the updateFuncs left the status unchanged). It aborts early if ctx is already done.
+func UpdateStatus(ctx context.Context, client OperatorClient, updateFuncs ...UpdateStatusFunc) (*operatorv1.OperatorStatus, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, false, err
+	}
+
+	updated := false
+	var result *operatorv1.OperatorStatus
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		_, oldStatus, resourceVersion, err := client.Get()
+		if err != nil {
+			return err
+		}
+
+		newStatus := oldStatus.DeepCopy()
+		for _, update := range updateFuncs {
+			if err := update(newStatus); err != nil {
+				return err
+			}
+		}
+
+		if equality.Semantic.DeepEqual(oldStatus, newStatus) {
+			result = oldStatus
+			return nil
+		}
+
+		result, err = client.UpdateStatus(newStatus, resourceVersion)
+		updated = err == nil
+		return err
+	})
+
+	return result, updated, err
+}
+
+// NewMultiLineAggregate joins the messages of errs, one per line, into a single error. It returns nil
+// if errs contains no non-nil errors.
+func NewMultiLineAggregate(errs []error) error {
+	messages := make([]string, 0, len(errs))
+	for _, e := range errs {
+		if e == nil {
+			continue
+		}
+		messages = append(messages, e.Error())
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(messages, "\n"))
+}